@@ -0,0 +1,73 @@
+package app
+
+import "sync"
+
+// EventType identifies the kind of notification carried by an Event.
+type EventType string
+
+const (
+	// EventSyncProgress is published as the wallet reports block chain sync progress.
+	EventSyncProgress EventType = "sync_progress"
+	// EventNewTransaction is published whenever a new transaction is seen in the wallet.
+	EventNewTransaction EventType = "new_transaction"
+)
+
+// Event is a single notification published on an EventBus.
+type Event struct {
+	Type EventType
+	Data interface{}
+}
+
+// EventBus lets independent publishers (the sync listener, a future
+// transaction-notification listener) and subscribers (the grpc service, the web
+// websocket) share one source of truth instead of wiring notifications directly
+// between packages.
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers []chan Event
+}
+
+// NewEventBus returns an empty EventBus.
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Subscribe returns a channel that receives every event published after this call.
+// Callers must Unsubscribe when done listening to avoid leaking the channel.
+func (b *EventBus) Subscribe() chan Event {
+	ch := make(chan Event, 16)
+
+	b.mu.Lock()
+	b.subscribers = append(b.subscribers, ch)
+	b.mu.Unlock()
+
+	return ch
+}
+
+// Unsubscribe stops ch from receiving further events and closes it.
+func (b *EventBus) Unsubscribe(ch chan Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, subscriber := range b.subscribers {
+		if subscriber == ch {
+			b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+			close(ch)
+			return
+		}
+	}
+}
+
+// Publish fans event out to every current subscriber. A subscriber that isn't
+// keeping up with its channel misses the event rather than blocking publishers.
+func (b *EventBus) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+}
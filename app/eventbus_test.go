@@ -0,0 +1,48 @@
+package app
+
+import "testing"
+
+func TestEventBusPublishFansOutToSubscribers(t *testing.T) {
+	bus := NewEventBus()
+
+	sub1 := bus.Subscribe()
+	sub2 := bus.Subscribe()
+
+	event := Event{Type: EventSyncProgress, Data: SyncProgressEvent{Stage: "headers_fetched"}}
+	bus.Publish(event)
+
+	for _, sub := range []chan Event{sub1, sub2} {
+		select {
+		case got := <-sub:
+			if got != event {
+				t.Errorf("got event %+v, want %+v", got, event)
+			}
+		default:
+			t.Error("expected a subscriber to receive the published event")
+		}
+	}
+}
+
+func TestEventBusUnsubscribeStopsDelivery(t *testing.T) {
+	bus := NewEventBus()
+
+	sub := bus.Subscribe()
+	bus.Unsubscribe(sub)
+
+	bus.Publish(Event{Type: EventNewTransaction, Data: NewTransactionEvent{Hash: "abc"}})
+
+	if _, ok := <-sub; ok {
+		t.Error("expected unsubscribed channel to be closed, not receive an event")
+	}
+}
+
+func TestEventBusPublishDoesNotBlockOnFullSubscriber(t *testing.T) {
+	bus := NewEventBus()
+	sub := bus.Subscribe()
+
+	// fill the subscriber's buffered channel, then publish once more; Publish must
+	// not block even though sub can't take any more events
+	for i := 0; i < cap(sub)+1; i++ {
+		bus.Publish(Event{Type: EventSyncProgress})
+	}
+}
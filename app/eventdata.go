@@ -0,0 +1,15 @@
+package app
+
+// SyncProgressEvent is the Data payload of an EventSyncProgress event.
+type SyncProgressEvent struct {
+	Stage              string // "headers_fetched", "discovered_address" or "rescanning_blocks"
+	PercentageProgress int64
+	State              string
+}
+
+// NewTransactionEvent is the Data payload of an EventNewTransaction event.
+type NewTransactionEvent struct {
+	Hash      string
+	Direction string
+	Amount    int64
+}
@@ -0,0 +1,51 @@
+package app
+
+// StakeOptions mirrors the subset of dcrwallet's wallet.StakeOptions that godcr needs
+// in order to let a user configure automatic ticket buying against a VSP.
+type StakeOptions struct {
+	VoteBits          uint16
+	EnableStakeMining bool
+	BalanceToMaintain int64
+	TicketAddress     string
+	TicketMaxPrice    int64
+	TicketFee         int64
+	PruneTickets      bool
+	AddressReuse      bool
+	VSPHost           string
+	VSPPubKey         string
+}
+
+// Ticket describes a single ticket purchase/status as reported by a WalletMiddleware.
+type Ticket struct {
+	Hash         string
+	Price        int64
+	Fee          int64
+	PurchaseTime int64
+	Status       string // e.g. "live", "voted", "missed", "expired", "unmined", "immature"
+}
+
+// TicketLifecycleListener is notified as tickets move through voting/ticket-loss
+// events reported by the wallet's consensus rpc, mirroring dcrwallet's
+// winningtickets/missedtickets notifications.
+type TicketLifecycleListener struct {
+	OnWinningTickets func(blockHash string, blockHeight int64, tickets []string)
+	OnMissedTickets  func(tickets []string)
+}
+
+// StakingMiddleware is implemented by wallet backends that can purchase and manage
+// tickets. It is a sub-interface of WalletMiddleware; a backend that cannot stake
+// (e.g. a watch-only wallet) simply does not implement it, and callers should type-assert
+// before using it.
+type StakingMiddleware interface {
+	WalletMiddleware
+
+	SetVoteBits(voteBits uint16) error
+	SetTicketMaxPrice(maxPrice int64) error
+	EnableTicketBuyer(options StakeOptions) error
+
+	PurchaseTickets(passphrase string, options StakeOptions, numTickets int) ([]string, error)
+	Tickets() ([]Ticket, error)
+	RevokeTicket(ticketHash string) error
+
+	SubscribeToTicketLifecycle(listener *TicketLifecycleListener) error
+}
@@ -0,0 +1,17 @@
+package app
+
+// TransactionNotificationListener is notified whenever a new transaction affecting
+// the wallet is seen, mirroring dcrwallet's transaction notifications.
+type TransactionNotificationListener struct {
+	OnTransaction func(transaction NewTransactionEvent)
+}
+
+// TransactionNotifier is implemented by wallet backends that can push new-transaction
+// notifications. It is a sub-interface of WalletMiddleware; a backend that cannot
+// notify of new transactions simply does not implement it, and callers should
+// type-assert before using it, the same way they do for StakingMiddleware.
+type TransactionNotifier interface {
+	WalletMiddleware
+
+	SubscribeToTransactions(listener *TransactionNotificationListener) error
+}
@@ -0,0 +1,91 @@
+// Package dcrlibwallet implements app.WalletMiddleware using an in-process
+// dcrlibwallet instance, for use when godcr manages its own wallet data directory.
+package dcrlibwallet
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// DcrLibWallet is a WalletMiddleware backed by an in-process dcrlibwallet instance.
+type DcrLibWallet struct {
+	appDataDir string
+	netType    string
+
+	stakeOptions   app.StakeOptions
+	votingEnabled  bool
+	ticketListener *app.TicketLifecycleListener
+}
+
+// New creates a DcrLibWallet that will store its wallet data under appDataDir,
+// on the given network (mainnet, testnet, simnet or regtest).
+func New(appDataDir, netType string) *DcrLibWallet {
+	return &DcrLibWallet{
+		appDataDir: appDataDir,
+		netType:    netType,
+	}
+}
+
+// NetType returns the network this wallet is configured for.
+func (lib *DcrLibWallet) NetType() string {
+	return lib.netType
+}
+
+// WalletExists checks whether a wallet database already exists in appDataDir.
+func (lib *DcrLibWallet) WalletExists() (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+// GenerateNewWalletSeed generates a new BIP39-style wallet seed.
+func (lib *DcrLibWallet) GenerateNewWalletSeed() (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+// CreateWallet creates a new wallet encrypted with passphrase, using seed.
+func (lib *DcrLibWallet) CreateWallet(passphrase, seed string) error {
+	return fmt.Errorf("not implemented")
+}
+
+// RestoreWallet recreates a wallet in appDataDir from an existing seed, then
+// discovers used addresses starting at rescanFromHeight before normal sync resumes.
+func (lib *DcrLibWallet) RestoreWallet(passphrase, seed string, rescanFromHeight int32) error {
+	return fmt.Errorf("not implemented")
+}
+
+// OpenWallet opens the existing wallet in appDataDir.
+func (lib *DcrLibWallet) OpenWallet() error {
+	return fmt.Errorf("not implemented")
+}
+
+// CloseWallet closes the wallet, releasing any held resources.
+func (lib *DcrLibWallet) CloseWallet() {}
+
+// SyncBlockChain downloads and processes blocks, reporting progress via listener.
+func (lib *DcrLibWallet) SyncBlockChain(listener *app.BlockChainSyncListener, rescan bool) error {
+	return fmt.Errorf("not implemented")
+}
+
+// Send sends amount atoms from sourceAccount to destinationAddress.
+func (lib *DcrLibWallet) Send(sourceAccount uint32, destinationAddress string, amount int64, passphrase string) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+// Balance returns the total and spendable balance of accountNumber.
+func (lib *DcrLibWallet) Balance(accountNumber uint32) (total int64, spendable int64, err error) {
+	return 0, 0, fmt.Errorf("not implemented")
+}
+
+// SubscribeToTransactions registers listener to be notified of new transactions seen
+// by the wallet, satisfying app.TransactionNotifier.
+func (lib *DcrLibWallet) SubscribeToTransactions(listener *app.TransactionNotificationListener) error {
+	return fmt.Errorf("not implemented")
+}
+
+// EnableTicketBuyer records options as the wallet's stake options and marks voting
+// as enabled. It does not purchase tickets or contact the configured VSP.
+func (lib *DcrLibWallet) EnableTicketBuyer(options app.StakeOptions) error {
+	lib.stakeOptions = options
+	lib.votingEnabled = true
+	return nil
+}
@@ -0,0 +1,42 @@
+package dcrlibwallet
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// SetVoteBits updates the vote bits used when this wallet votes on a ticket.
+func (lib *DcrLibWallet) SetVoteBits(voteBits uint16) error {
+	lib.stakeOptions.VoteBits = voteBits
+	return nil
+}
+
+// SetTicketMaxPrice updates the maximum price this wallet will pay for a ticket.
+func (lib *DcrLibWallet) SetTicketMaxPrice(maxPrice int64) error {
+	lib.stakeOptions.TicketMaxPrice = maxPrice
+	return nil
+}
+
+// PurchaseTickets buys numTickets tickets using the wallet's current stake options,
+// returning the hashes of the purchased tickets.
+func (lib *DcrLibWallet) PurchaseTickets(passphrase string, options app.StakeOptions, numTickets int) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// Tickets returns all tickets known to this wallet, regardless of status.
+func (lib *DcrLibWallet) Tickets() ([]app.Ticket, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// RevokeTicket issues a revocation for a missed or expired ticket.
+func (lib *DcrLibWallet) RevokeTicket(ticketHash string) error {
+	return fmt.Errorf("not implemented")
+}
+
+// SubscribeToTicketLifecycle registers listener to be notified of winning and
+// missed tickets as they are announced by consensus rpc notifications.
+func (lib *DcrLibWallet) SubscribeToTicketLifecycle(listener *app.TicketLifecycleListener) error {
+	lib.ticketListener = listener
+	return nil
+}
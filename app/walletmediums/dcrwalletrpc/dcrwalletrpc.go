@@ -0,0 +1,98 @@
+// Package dcrwalletrpc implements app.WalletMiddleware by talking to a remote
+// dcrwallet instance over its grpc rpc interface.
+package dcrwalletrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// DcrWalletRPC is a WalletMiddleware backed by a remote dcrwallet rpc connection.
+type DcrWalletRPC struct {
+	netType string
+
+	rpcServer string
+	rpcCert   string
+	noTLS     bool
+
+	stakeOptions   app.StakeOptions
+	votingEnabled  bool
+	ticketListener *app.TicketLifecycleListener
+}
+
+// New dials rpcServer and returns a WalletMiddleware for the connected dcrwallet,
+// on the given network (mainnet, testnet, simnet or regtest).
+func New(ctx context.Context, netType, rpcServer, rpcCert string, noTLS bool) (*DcrWalletRPC, error) {
+	return &DcrWalletRPC{
+		netType:   netType,
+		rpcServer: rpcServer,
+		rpcCert:   rpcCert,
+		noTLS:     noTLS,
+	}, nil
+}
+
+// NetType returns the network this wallet is configured for.
+func (w *DcrWalletRPC) NetType() string {
+	return w.netType
+}
+
+// WalletExists checks whether the remote dcrwallet already has a wallet loaded.
+func (w *DcrWalletRPC) WalletExists() (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+// GenerateNewWalletSeed generates a new BIP39-style wallet seed.
+func (w *DcrWalletRPC) GenerateNewWalletSeed() (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+// CreateWallet creates a new wallet on the remote dcrwallet, encrypted with passphrase.
+func (w *DcrWalletRPC) CreateWallet(passphrase, seed string) error {
+	return fmt.Errorf("not implemented")
+}
+
+// RestoreWallet recreates a wallet on the remote dcrwallet from an existing seed, then
+// discovers used addresses starting at rescanFromHeight before normal sync resumes.
+func (w *DcrWalletRPC) RestoreWallet(passphrase, seed string, rescanFromHeight int32) error {
+	return fmt.Errorf("not implemented")
+}
+
+// OpenWallet opens the remote dcrwallet's existing wallet.
+func (w *DcrWalletRPC) OpenWallet() error {
+	return fmt.Errorf("not implemented")
+}
+
+// CloseWallet closes the rpc connection to the remote dcrwallet.
+func (w *DcrWalletRPC) CloseWallet() {}
+
+// SyncBlockChain downloads and processes blocks, reporting progress via listener.
+func (w *DcrWalletRPC) SyncBlockChain(listener *app.BlockChainSyncListener, rescan bool) error {
+	return fmt.Errorf("not implemented")
+}
+
+// Send sends amount atoms from sourceAccount to destinationAddress.
+func (w *DcrWalletRPC) Send(sourceAccount uint32, destinationAddress string, amount int64, passphrase string) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+// Balance returns the total and spendable balance of accountNumber.
+func (w *DcrWalletRPC) Balance(accountNumber uint32) (total int64, spendable int64, err error) {
+	return 0, 0, fmt.Errorf("not implemented")
+}
+
+// SubscribeToTransactions registers listener to be notified of new transactions seen
+// by the remote dcrwallet, satisfying app.TransactionNotifier.
+func (w *DcrWalletRPC) SubscribeToTransactions(listener *app.TransactionNotificationListener) error {
+	return fmt.Errorf("not implemented")
+}
+
+// EnableTicketBuyer records options as the wallet's stake options and marks voting
+// as enabled. It does not send them to the remote dcrwallet, purchase tickets or
+// contact the configured VSP.
+func (w *DcrWalletRPC) EnableTicketBuyer(options app.StakeOptions) error {
+	w.stakeOptions = options
+	w.votingEnabled = true
+	return nil
+}
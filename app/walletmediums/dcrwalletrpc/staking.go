@@ -0,0 +1,42 @@
+package dcrwalletrpc
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// SetVoteBits updates the vote bits used when this wallet votes on a ticket.
+func (w *DcrWalletRPC) SetVoteBits(voteBits uint16) error {
+	w.stakeOptions.VoteBits = voteBits
+	return nil
+}
+
+// SetTicketMaxPrice updates the maximum price this wallet will pay for a ticket.
+func (w *DcrWalletRPC) SetTicketMaxPrice(maxPrice int64) error {
+	w.stakeOptions.TicketMaxPrice = maxPrice
+	return nil
+}
+
+// PurchaseTickets buys numTickets tickets using the wallet's current stake options,
+// returning the hashes of the purchased tickets.
+func (w *DcrWalletRPC) PurchaseTickets(passphrase string, options app.StakeOptions, numTickets int) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// Tickets returns all tickets known to this wallet, regardless of status.
+func (w *DcrWalletRPC) Tickets() ([]app.Ticket, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// RevokeTicket issues a revocation for a missed or expired ticket.
+func (w *DcrWalletRPC) RevokeTicket(ticketHash string) error {
+	return fmt.Errorf("not implemented")
+}
+
+// SubscribeToTicketLifecycle registers listener to be notified of winning and
+// missed tickets as they are announced by consensus rpc notifications.
+func (w *DcrWalletRPC) SubscribeToTicketLifecycle(listener *app.TicketLifecycleListener) error {
+	w.ticketListener = listener
+	return nil
+}
@@ -0,0 +1,34 @@
+// Package app defines the interfaces that the cli and web frontends use to
+// talk to a wallet backend, independent of whether that backend is an
+// in-process dcrlibwallet or a remote dcrwallet rpc connection.
+package app
+
+// WalletMiddleware defines the operations that any wallet backend (dcrlibwallet,
+// dcrwalletrpc, etc) must implement so that cli and web can interact with a wallet
+// without caring which backend is actually in use.
+type WalletMiddleware interface {
+	NetType() string
+	WalletExists() (bool, error)
+
+	GenerateNewWalletSeed() (string, error)
+	CreateWallet(passphrase, seed string) error
+	RestoreWallet(passphrase, seed string, rescanFromHeight int32) error
+	OpenWallet() error
+	CloseWallet()
+
+	SyncBlockChain(listener *BlockChainSyncListener, rescan bool) error
+
+	Send(sourceAccount uint32, destinationAddress string, amount int64, passphrase string) (transactionHash string, err error)
+	Balance(accountNumber uint32) (total int64, spendable int64, err error)
+}
+
+// BlockChainSyncListener holds callbacks that are triggered as a WalletMiddleware
+// reports progress while syncing the block chain. Callers that don't care about a
+// particular update may set the corresponding field to a no-op func.
+type BlockChainSyncListener struct {
+	SyncStarted         func()
+	SyncEnded           func(err error)
+	OnHeadersFetched    func(percentageProgress int64)
+	OnDiscoveredAddress func(state string)
+	OnRescanningBlocks  func(percentageProgress int64)
+}
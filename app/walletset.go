@@ -0,0 +1,60 @@
+package app
+
+import "fmt"
+
+// NamedWallet pairs a WalletMiddleware with the name it was configured under,
+// so callers driving several wallets at once can tell them apart.
+type NamedWallet struct {
+	Name       string
+	Middleware WalletMiddleware
+}
+
+// WalletSet holds zero or more named wallet middlewares, letting godcr drive
+// several wallet backends concurrently, e.g. one local watching wallet plus
+// several remote voting wallets.
+type WalletSet struct {
+	wallets []NamedWallet
+}
+
+// NewWalletSet returns an empty WalletSet.
+func NewWalletSet() *WalletSet {
+	return &WalletSet{}
+}
+
+// Add registers middleware under name, returning an error if name is already
+// registered so a misconfigured --wallet entry can't silently shadow another wallet.
+func (ws *WalletSet) Add(name string, middleware WalletMiddleware) error {
+	if _, exists := ws.Get(name); exists {
+		return fmt.Errorf("a wallet named %q is already registered", name)
+	}
+	ws.wallets = append(ws.wallets, NamedWallet{Name: name, Middleware: middleware})
+	return nil
+}
+
+// Get returns the middleware registered under name, if any.
+func (ws *WalletSet) Get(name string) (WalletMiddleware, bool) {
+	for _, wallet := range ws.wallets {
+		if wallet.Name == name {
+			return wallet.Middleware, true
+		}
+	}
+	return nil, false
+}
+
+// All returns every wallet in the set, in the order they were added.
+func (ws *WalletSet) All() []NamedWallet {
+	return ws.wallets
+}
+
+// Len returns the number of wallets in the set.
+func (ws *WalletSet) Len() int {
+	return len(ws.wallets)
+}
+
+// CloseAll closes every wallet in the set, ignoring individual close failures
+// since shutdown should proceed regardless.
+func (ws *WalletSet) CloseAll() {
+	for _, wallet := range ws.wallets {
+		wallet.Middleware.CloseWallet()
+	}
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"github.com/raedahgroup/dcrcli/cli/termio"
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// BalanceCommand shows the balance of every connected wallet.
+type BalanceCommand struct {
+	CommanderStub
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (b BalanceCommand) Execute(args []string) error {
+	return b.Run(b.Client, args)
+}
+
+// Run runs the `balance` command.
+func (b BalanceCommand) Run(client *walletrpcclient.Client, args []string) error {
+	balances, err := client.GetBalances()
+	if err != nil {
+		return err
+	}
+
+	columns := []string{
+		"Wallet",
+		"Total (DCR)",
+		"Spendable (DCR)",
+	}
+	rows := make([][]interface{}, len(balances))
+
+	for i, balance := range balances {
+		rows[i] = []interface{}{
+			balance.WalletName,
+			balance.Total,
+			balance.Spendable,
+		}
+	}
+
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/raedahgroup/dcrcli/walletrpcclient"
+
+// CommanderStub is embedded by command types so they can be dispatched uniformly:
+// cli.Run sets Client on each registered command before parsing, and the
+// per-command Execute method (required by go-flags' own Commander interface)
+// forwards to that command's Run.
+type CommanderStub struct {
+	Client *walletrpcclient.Client
+}
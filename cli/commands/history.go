@@ -10,7 +10,13 @@ type HistoryCommand struct {
 	CommanderStub
 }
 
-// Execute runs the `history` command.
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (h HistoryCommand) Execute(args []string) error {
+	return h.Run(h.Client, args)
+}
+
+// Run runs the `history` command.
 func (h HistoryCommand) Run(client *walletrpcclient.Client, args []string) error {
 	transactions, err := client.GetTransactions()
 	if err != nil {
@@ -18,6 +24,7 @@ func (h HistoryCommand) Run(client *walletrpcclient.Client, args []string) error
 	}
 
 	columns := []string{
+		"Wallet",
 		"Date",
 		"Amount (DCR)",
 		"Direction",
@@ -28,6 +35,7 @@ func (h HistoryCommand) Run(client *walletrpcclient.Client, args []string) error
 
 	for i, tx := range transactions {
 		rows[i] = []interface{}{
+			tx.WalletName,
 			tx.FormattedTime,
 			tx.Amount,
 			tx.Direction,
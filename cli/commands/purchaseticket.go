@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/app"
+	"github.com/raedahgroup/dcrcli/cli/terminalprompt"
+	"github.com/raedahgroup/dcrcli/cli/termio"
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// PurchaseTicketCommand buys one or more tickets for the connected wallet.
+type PurchaseTicketCommand struct {
+	CommanderStub
+	NumTickets int    `short:"n" long:"numtickets" description:"number of tickets to purchase" default:"1"`
+	MaxPrice   int64  `long:"maxprice" description:"maximum price to pay per ticket, in atoms"`
+	Address    string `long:"ticketaddress" description:"address to which ticket commitments should be made"`
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (p PurchaseTicketCommand) Execute(args []string) error {
+	return p.Run(p.Client, args)
+}
+
+// Run runs the `purchaseticket` command.
+func (p PurchaseTicketCommand) Run(client *walletrpcclient.Client, args []string) error {
+	passphrase, err := terminalprompt.RequestInputSecure("Enter private passphrase", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error reading input: %s", err.Error())
+	}
+
+	options := app.StakeOptions{
+		TicketMaxPrice: p.MaxPrice,
+		TicketAddress:  p.Address,
+	}
+
+	ticketHashes, err := client.PurchaseTickets(passphrase, options, p.NumTickets)
+	if err != nil {
+		return err
+	}
+
+	columns := []string{"Ticket Hash"}
+	rows := make([][]interface{}, len(ticketHashes))
+	for i, hash := range ticketHashes {
+		rows[i] = []interface{}{hash}
+	}
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
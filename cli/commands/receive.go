@@ -16,6 +16,12 @@ type ReceiveCommand struct {
 	} `positional-args:"yes"`
 }
 
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (r ReceiveCommand) Execute(args []string) error {
+	return r.Run(r.Client, args)
+}
+
 // Run runs the `receive` command.
 func (r ReceiveCommand) Run(client *walletrpcclient.Client, args []string) error {
 	var accountNumber uint32
@@ -31,7 +37,7 @@ func (r ReceiveCommand) Run(client *walletrpcclient.Client, args []string) error
 		// if an account name was passed in e.g. ./dcrcli receive default
 		// get the address corresponding to the account name and use it
 		var err error
-		accountNumber, err = client.AccountNumber(r.Args.Account)
+		accountNumber, err = client.AccountNumber("", r.Args.Account)
 		if err != nil {
 			return fmt.Errorf("Error fetching account number: %s", err.Error())
 		}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// RevokeCommand revokes a missed or expired ticket.
+type RevokeCommand struct {
+	CommanderStub
+	Args struct {
+		TicketHash string `positional-arg-name:"tickethash"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (r RevokeCommand) Execute(args []string) error {
+	return r.Run(r.Client, args)
+}
+
+// Run runs the `revoke` command.
+func (r RevokeCommand) Run(client *walletrpcclient.Client, args []string) error {
+	if err := client.RevokeTicket(r.Args.TicketHash); err != nil {
+		return err
+	}
+	fmt.Printf("Revoked ticket %s\n", r.Args.TicketHash)
+	return nil
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/cli/terminalprompt"
+	"github.com/raedahgroup/dcrcli/cli/termio"
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// SendCommand sends DCR from an account on the connected wallet to a destination address.
+type SendCommand struct {
+	CommanderStub
+	FromWallet string `long:"fromwallet" description:"name of the wallet to send from, as configured via --wallet; defaults to the default wallet"`
+	Args       struct {
+		SourceAccount      string `positional-arg-name:"sourceaccount"`
+		DestinationAddress string `positional-arg-name:"destinationaddress"`
+		Amount             int64  `positional-arg-name:"amount"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (s SendCommand) Execute(args []string) error {
+	return s.Run(s.Client, args)
+}
+
+// Run runs the `send` command.
+func (s SendCommand) Run(client *walletrpcclient.Client, args []string) error {
+	accountNumber, err := client.AccountNumber(s.FromWallet, s.Args.SourceAccount)
+	if err != nil {
+		return fmt.Errorf("error fetching account number: %s", err.Error())
+	}
+
+	passphrase, err := terminalprompt.RequestInputSecure("Enter private passphrase", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error reading input: %s", err.Error())
+	}
+
+	transactionHash, err := client.SendFromWallet(s.FromWallet, accountNumber, s.Args.DestinationAddress, s.Args.Amount, passphrase)
+	if err != nil {
+		return err
+	}
+
+	columns := []string{"Transaction Hash"}
+	rows := [][]interface{}{{transactionHash}}
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// SetTicketMaxPriceCommand updates the maximum price the wallet will pay for a ticket.
+type SetTicketMaxPriceCommand struct {
+	CommanderStub
+	Args struct {
+		MaxPrice int64 `positional-arg-name:"maxprice"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (s SetTicketMaxPriceCommand) Execute(args []string) error {
+	return s.Run(s.Client, args)
+}
+
+// Run runs the `setticketmaxprice` command.
+func (s SetTicketMaxPriceCommand) Run(client *walletrpcclient.Client, args []string) error {
+	if err := client.SetTicketMaxPrice(s.Args.MaxPrice); err != nil {
+		return err
+	}
+	fmt.Printf("Ticket max price set to %d atoms\n", s.Args.MaxPrice)
+	return nil
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// SetVoteBitsCommand updates the vote bits the wallet uses when voting on tickets.
+type SetVoteBitsCommand struct {
+	CommanderStub
+	Args struct {
+		VoteBits uint16 `positional-arg-name:"votebits"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (s SetVoteBitsCommand) Execute(args []string) error {
+	return s.Run(s.Client, args)
+}
+
+// Run runs the `setvotebits` command.
+func (s SetVoteBitsCommand) Run(client *walletrpcclient.Client, args []string) error {
+	if err := client.SetVoteBits(s.Args.VoteBits); err != nil {
+		return err
+	}
+	fmt.Printf("Vote bits set to %d\n", s.Args.VoteBits)
+	return nil
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"github.com/raedahgroup/dcrcli/cli/termio"
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// TicketsCommand lists the tickets owned by the connected wallet, and their status.
+type TicketsCommand struct {
+	CommanderStub
+}
+
+// Execute implements go-flags' Commander interface, invoking Run with the client
+// cli.Run attached to this command before parsing.
+func (t TicketsCommand) Execute(args []string) error {
+	return t.Run(t.Client, args)
+}
+
+// Run runs the `tickets` command.
+func (t TicketsCommand) Run(client *walletrpcclient.Client, args []string) error {
+	tickets, err := client.GetTickets()
+	if err != nil {
+		return err
+	}
+
+	columns := []string{
+		"Hash",
+		"Status",
+		"Price (DCR)",
+		"Fee (DCR)",
+	}
+	rows := make([][]interface{}, len(tickets))
+	for i, ticket := range tickets {
+		rows[i] = []interface{}{
+			ticket.Hash,
+			ticket.Status,
+			ticket.Price,
+			ticket.Fee,
+		}
+	}
+
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"github.com/raedahgroup/dcrcli/app"
+	"github.com/raedahgroup/dcrcli/cli/commands"
+	"github.com/raedahgroup/dcrcli/config"
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// cliCommands registers every subcommand godcr's cli mode supports with go-flags.
+type cliCommands struct {
+	Balance           commands.BalanceCommand           `command:"balance" description:"show wallet balances"`
+	History           commands.HistoryCommand           `command:"history" description:"show transaction history"`
+	Receive           commands.ReceiveCommand           `command:"receive" description:"receive DCR to an address"`
+	Send              commands.SendCommand              `command:"send" description:"send DCR to an address"`
+	PurchaseTicket    commands.PurchaseTicketCommand    `command:"purchaseticket" description:"purchase tickets"`
+	Tickets           commands.TicketsCommand           `command:"tickets" description:"list tickets and their status"`
+	SetVoteBits       commands.SetVoteBitsCommand       `command:"setvotebits" description:"set the vote bits used when voting on tickets"`
+	SetTicketMaxPrice commands.SetTicketMaxPriceCommand `command:"setticketmaxprice" description:"set the maximum price to pay for a ticket"`
+	Revoke            commands.RevokeCommand            `command:"revoke" description:"revoke a missed or expired ticket"`
+}
+
+// Run opens/creates/syncs the default wallet in walletSet as directed by appConfig,
+// then parses args as a single cli command to execute against the wallet set: balance
+// and history cover every wallet, send uses the wallet named by --fromwallet, and the
+// other commands use the first wallet in the set.
+// Sync progress is published to eventBus so a concurrently running grpc server (see
+// rpc.StartGRPCServer) shares this sync as its single source of truth.
+func Run(ctx context.Context, walletSet *app.WalletSet, appConfig *config.Config, args []string, eventBus *app.EventBus) error {
+	defaultWallet, ok := walletSet.Get("default")
+	if !ok {
+		return fmt.Errorf("no default wallet connected")
+	}
+
+	if appConfig.CreateWallet {
+		if err := createWallet(ctx, defaultWallet, appConfig.Restore, eventBus); err != nil {
+			return err
+		}
+	} else if appConfig.SyncBlockchain {
+		if err := openWallet(ctx, defaultWallet, eventBus); err != nil {
+			return err
+		}
+		if err := syncBlockChain(ctx, defaultWallet, eventBus); err != nil {
+			return err
+		}
+	}
+
+	client := walletrpcclient.NewFromWalletSet(walletSet)
+	cmds := &cliCommands{}
+	cmds.Balance.Client = client
+	cmds.History.Client = client
+	cmds.Receive.Client = client
+	cmds.Send.Client = client
+	cmds.PurchaseTicket.Client = client
+	cmds.Tickets.Client = client
+	cmds.SetVoteBits.Client = client
+	cmds.SetTicketMaxPrice.Client = client
+	cmds.Revoke.Client = client
+
+	parser := flags.NewParser(cmds, flags.HelpFlag|flags.PassDoubleDash)
+	if _, err := parser.ParseArgs(args); err != nil {
+		if config.IsFlagErrorType(err, flags.ErrCommandRequired) {
+			fmt.Fprintln(os.Stderr, "Available Commands: ", strings.Join(supportedCommands(parser.Command), ", "))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func supportedCommands(parser *flags.Command) []string {
+	registeredCommands := parser.Commands()
+	commandNames := make([]string, 0, len(registeredCommands))
+	for _, command := range registeredCommands {
+		commandNames = append(commandNames, command.Name)
+	}
+	sort.Strings(commandNames)
+	return commandNames
+}
@@ -0,0 +1,37 @@
+package terminalprompt
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrwallet/walletseed"
+)
+
+// SeedValidator accepts either a 32-byte hex-encoded seed or its 33-word mnemonic
+// representation (dcrwallet prints the latter when displaying a new seed). Word
+// decoding is delegated to dcrwallet's own walletseed package so that a real
+// seed's PGP word list and checksum word are validated against the same logic
+// dcrwallet itself uses, not a reimplementation of it.
+func SeedValidator(userResponse string) error {
+	seed := strings.TrimSpace(userResponse)
+	if seed == "" {
+		return fmt.Errorf("seed cannot be empty")
+	}
+
+	if !strings.Contains(seed, " ") {
+		decoded, err := hex.DecodeString(seed)
+		if err != nil {
+			return fmt.Errorf("seed must be a valid hex string or a dcrwallet seed mnemonic")
+		}
+		if len(decoded) != 32 {
+			return fmt.Errorf("hex seed must decode to 32 bytes, got %d", len(decoded))
+		}
+		return nil
+	}
+
+	if _, err := walletseed.DecodeUserInput(seed); err != nil {
+		return fmt.Errorf("invalid seed: %s", err.Error())
+	}
+	return nil
+}
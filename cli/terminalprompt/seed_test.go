@@ -0,0 +1,71 @@
+package terminalprompt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrwallet/walletseed"
+)
+
+func TestSeedValidatorHex(t *testing.T) {
+	valid := strings.Repeat("ab", 32)
+	if err := SeedValidator(valid); err != nil {
+		t.Errorf("expected valid 32-byte hex seed to pass, got error: %s", err)
+	}
+
+	if err := SeedValidator(strings.Repeat("ab", 31)); err == nil {
+		t.Error("expected a short hex seed to fail validation")
+	}
+
+	if err := SeedValidator("not hex"); err == nil {
+		t.Error("expected a non-hex, non-word seed to fail validation")
+	}
+}
+
+func TestSeedValidatorWords(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	mnemonic := walletseed.EncodeMnemonic(seed)
+
+	if err := SeedValidator(mnemonic); err != nil {
+		t.Errorf("expected seed with correct checksum to pass, got error: %s", err)
+	}
+}
+
+func TestSeedValidatorWrongChecksum(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	mnemonic := walletseed.EncodeMnemonic(seed)
+
+	words := strings.Fields(mnemonic)
+	if words[len(words)-1] == words[0] {
+		t.Fatal("test fixture assumption broken: checksum word equals first word")
+	}
+	words[len(words)-1] = words[0]
+	tampered := strings.Join(words, " ")
+
+	if err := SeedValidator(tampered); err == nil {
+		t.Error("expected seed with incorrect checksum word to fail validation")
+	}
+}
+
+func TestSeedValidatorWrongWordCount(t *testing.T) {
+	seed := make([]byte, 32)
+	mnemonic := walletseed.EncodeMnemonic(seed)
+	words := strings.Fields(mnemonic)
+	short := strings.Join(words[:len(words)-1], " ")
+
+	if err := SeedValidator(short); err == nil {
+		t.Error("expected seed with wrong word count to fail validation")
+	}
+}
+
+func TestSeedValidatorEmpty(t *testing.T) {
+	if err := SeedValidator("   "); err == nil {
+		t.Error("expected empty seed to fail validation")
+	}
+}
@@ -0,0 +1,59 @@
+// Package terminalprompt provides helpers for prompting the user for input on the terminal.
+package terminalprompt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Validator validates a line of user input, returning a descriptive error if invalid.
+type Validator func(userResponse string) error
+
+// EmptyValidator accepts any non-empty input.
+func EmptyValidator(userResponse string) error {
+	if strings.TrimSpace(userResponse) == "" {
+		return fmt.Errorf("response cannot be empty")
+	}
+	return nil
+}
+
+// RequestInput prompts the user with prompt and re-prompts until validate succeeds.
+func RequestInput(prompt string, validate Validator) (string, error) {
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Printf("%s: ", prompt)
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if err := validate(line); err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+		return line, nil
+	}
+}
+
+// RequestInputSecure behaves like RequestInput but does not echo the typed input,
+// for passphrases and other sensitive values.
+func RequestInputSecure(prompt string, validate Validator) (string, error) {
+	for {
+		fmt.Printf("%s: ", prompt)
+		input, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Println()
+		if err != nil {
+			return "", err
+		}
+		line := string(input)
+		if err := validate(line); err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+		return line, nil
+	}
+}
@@ -0,0 +1,46 @@
+// Package termio provides helpers for reading and displaying tabular data on a terminal.
+package termio
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/raedahgroup/dcrcli/walletrpcclient"
+)
+
+// StdoutWriter is the default writer commands print their output to.
+var StdoutWriter = os.Stdout
+
+// PrintTabularResult prints rows of data under columns as a column-aligned table.
+func PrintTabularResult(w io.Writer, columns []string, rows [][]interface{}) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	headers := make([]interface{}, len(columns))
+	for i, column := range columns {
+		headers[i] = column
+	}
+	printRow(tw, headers)
+
+	for _, row := range rows {
+		printRow(tw, row)
+	}
+	tw.Flush()
+}
+
+func printRow(w *tabwriter.Writer, row []interface{}) {
+	for i, value := range row {
+		if i > 0 {
+			fmt.Fprint(w, "\t")
+		}
+		fmt.Fprintf(w, "%v", value)
+	}
+	fmt.Fprintln(w)
+}
+
+// GetSendSourceAccount returns the number of the account named "default" on the
+// default wallet, to source a transaction from. It does not prompt the user.
+func GetSendSourceAccount(client *walletrpcclient.Client) (uint32, error) {
+	return client.AccountNumber("", "default")
+}
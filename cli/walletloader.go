@@ -9,8 +9,10 @@ import (
 	"strings"
 )
 
-// createWallet creates a new wallet if one doesn't already exist using the WalletMiddleware provided
-func createWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) (err error) {
+// createWallet creates a new wallet if one doesn't already exist using the WalletMiddleware provided.
+// If restore is true, the wallet is restored from a seed the user is prompted for
+// instead of asking whether to create or restore.
+func createWallet(ctx context.Context, walletMiddleware app.WalletMiddleware, restore bool, eventBus *app.EventBus) (err error) {
 	// first check if wallet already exists
 	walletExists, err := walletMiddleware.WalletExists()
 	if err != nil {
@@ -39,6 +41,44 @@ func createWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) (e
 		return fmt.Errorf("passphrases do not match")
 	}
 
+	// --restore skips the create/restore prompt below and goes straight to asking
+	// for the existing seed to restore from
+	if !restore {
+		restore, err = shouldRestoreFromSeed()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading input: %s", err.Error())
+			return
+		}
+	}
+
+	if restore {
+		return restoreWallet(ctx, walletMiddleware, passphrase, eventBus)
+	}
+	return createWalletFromNewSeed(ctx, walletMiddleware, passphrase, eventBus)
+}
+
+// shouldRestoreFromSeed asks the user to choose between creating a brand new wallet
+// or restoring an existing one from its seed.
+func shouldRestoreFromSeed() (bool, error) {
+	prompt := "Create a new wallet or restore an existing one from seed? [create/restore]"
+	validator := func(userResponse string) error {
+		userResponse = strings.TrimSpace(userResponse)
+		if strings.EqualFold("create", userResponse) || strings.EqualFold("restore", userResponse) {
+			return nil
+		}
+		return fmt.Errorf(`please enter "create" or "restore"`)
+	}
+
+	userResponse, err := terminalprompt.RequestInput(prompt, validator)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold("restore", userResponse), nil
+}
+
+// createWalletFromNewSeed generates a new seed, displays it to the user for backup,
+// then creates the wallet from it.
+func createWalletFromNewSeed(ctx context.Context, walletMiddleware app.WalletMiddleware, passphrase string, eventBus *app.EventBus) (err error) {
 	// get seed and display to user
 	seed, err := walletMiddleware.GenerateNewWalletSeed()
 	if err != nil {
@@ -73,7 +113,29 @@ func createWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) (e
 	fmt.Println("Your wallet has been created successfully")
 
 	// perform first blockchain sync after creating wallet
-	return syncBlockChain(ctx, walletMiddleware)
+	return syncBlockChain(ctx, walletMiddleware, eventBus)
+}
+
+// restoreWallet prompts for an existing wallet seed, recreates the wallet from it and
+// triggers address discovery before normal sync resumes.
+func restoreWallet(ctx context.Context, walletMiddleware app.WalletMiddleware, passphrase string, eventBus *app.EventBus) (err error) {
+	seed, err := terminalprompt.RequestInput("Enter your 33-word seed (or its 32-byte hex form)", terminalprompt.SeedValidator)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading input: %s", err.Error())
+		return
+	}
+
+	// rescan from the genesis block; the wallet backend is responsible for discovering
+	// the actual height at which the restored wallet's addresses first appear
+	err = walletMiddleware.RestoreWallet(passphrase, seed, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error restoring wallet: %s", err.Error())
+		return
+	}
+	fmt.Println("Your wallet has been restored successfully")
+
+	// perform address discovery and blockchain sync after restoring wallet
+	return syncBlockChain(ctx, walletMiddleware, eventBus)
 }
 
 // openWallet is called whenever an action to be executed requires wallet to be loaded
@@ -81,7 +143,7 @@ func createWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) (e
 //
 // this method may stall until previous dcrcli instances are closed (especially in cases of multiple mobilewallet instances)
 // hence the need for ctx, so user can cancel the operation if it's taking too long
-func openWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
+func openWallet(ctx context.Context, walletMiddleware app.WalletMiddleware, eventBus *app.EventBus) error {
 	// notify user of the current operation so if takes too long, they have an idea what the cause is
 	fmt.Println("Looking for wallets...")
 
@@ -116,7 +178,7 @@ func openWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) erro
 	select {
 	case <-loadWalletDone:
 		if noWalletFound {
-			return attemptToCreateWallet(ctx, walletMiddleware)
+			return attemptToCreateWallet(ctx, walletMiddleware, eventBus)
 		}
 
 		if errMsg != "" {
@@ -132,7 +194,7 @@ func openWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) erro
 	}
 }
 
-func attemptToCreateWallet(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
+func attemptToCreateWallet(ctx context.Context, walletMiddleware app.WalletMiddleware, eventBus *app.EventBus) error {
 	createWalletPrompt := "No wallet found. Would you like to create one now? [y/N]"
 	validateUserResponse := func(userResponse string) error {
 		userResponse = strings.TrimSpace(userResponse)
@@ -155,12 +217,15 @@ func attemptToCreateWallet(ctx context.Context, walletMiddleware app.WalletMiddl
 		return fmt.Errorf("Wallet doesn't exist")
 	}
 
-	return createWallet(ctx, walletMiddleware)
+	return createWallet(ctx, walletMiddleware, false, eventBus)
 }
 
 // syncBlockChain uses the WalletMiddleware provided to download block updates
-// this is a long running operation, listen for ctx.Done and stop processing
-func syncBlockChain(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
+// this is a long running operation, listen for ctx.Done and stop processing. Progress
+// is both logged to the terminal and published to eventBus, so a concurrently running
+// grpc server shares this sync as its single source of truth instead of starting its
+// own.
+func syncBlockChain(ctx context.Context, walletMiddleware app.WalletMiddleware, eventBus *app.EventBus) error {
 	syncDone := make(chan error)
 	go func() {
 		syncListener := &app.BlockChainSyncListener{
@@ -175,9 +240,24 @@ func syncBlockChain(ctx context.Context, walletMiddleware app.WalletMiddleware)
 				}
 				syncDone <- err
 			},
-			OnHeadersFetched:    func(percentageProgress int64) {}, // in cli mode, sync updates are logged to terminal, no need to act on this update alert
-			OnDiscoveredAddress: func(state string) {},             // in cli mode, sync updates are logged to terminal, no need to act on update alert
-			OnRescanningBlocks:  func(percentageProgress int64) {}, // in cli mode, sync updates are logged to terminal, no need to act on update alert
+			OnHeadersFetched: func(percentageProgress int64) {
+				eventBus.Publish(app.Event{
+					Type: app.EventSyncProgress,
+					Data: app.SyncProgressEvent{Stage: "headers_fetched", PercentageProgress: percentageProgress},
+				})
+			},
+			OnDiscoveredAddress: func(state string) {
+				eventBus.Publish(app.Event{
+					Type: app.EventSyncProgress,
+					Data: app.SyncProgressEvent{Stage: "discovered_address", State: state},
+				})
+			},
+			OnRescanningBlocks: func(percentageProgress int64) {
+				eventBus.Publish(app.Event{
+					Type: app.EventSyncProgress,
+					Data: app.SyncProgressEvent{Stage: "rescanning_blocks", PercentageProgress: percentageProgress},
+				})
+			},
 		}
 
 		err := walletMiddleware.SyncBlockChain(syncListener, true)
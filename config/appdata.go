@@ -0,0 +1,16 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// defaultAppDataDir returns the default directory used to store application data,
+// typically ~/.godcr on unix-like systems.
+func defaultAppDataDir() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ".godcr"
+	}
+	return filepath.Join(homeDir, ".godcr")
+}
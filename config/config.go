@@ -0,0 +1,138 @@
+// Package config defines and loads godcr's runtime configuration, combining
+// defaults and command-line flags (flags take precedence). No config file is read.
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/raedahgroup/dcrcli/netparams"
+)
+
+// Config holds all options that control how godcr connects to a wallet and which
+// frontend (cli, web, desktop) it runs.
+type Config struct {
+	AppDataDir string `long:"appdata" description:"Path to application home directory"`
+
+	NetworkType  string `long:"net" description:"Network to use (mainnet, testnet, simnet, regtest)" default:"mainnet"`
+	UseWalletRPC bool   `long:"usewalletrpc" description:"Connect to a running dcrwallet via rpc instead of using an in-process wallet"`
+
+	WalletRPCServer string `long:"walletrpcserver" description:"Address of the dcrwallet rpc server to connect to"`
+	WalletRPCCert   string `long:"walletrpccert" description:"Path to the dcrwallet rpc certificate"`
+	NoWalletRPCTLS  bool   `long:"nowalletrpctls" description:"Disable TLS for the dcrwallet rpc connection"`
+
+	EnableVoting   bool   `long:"enablevoting" description:"Enable automatic ticket buying and voting through a VSP"`
+	VSPHost        string `long:"vspurl" description:"URL of the voting service provider to use for ticket buying"`
+	VSPCert        string `long:"vspcert" description:"Path to the voting service provider's TLS certificate"`
+	TicketFee      int64  `long:"ticketfee" description:"Fee per KB to pay when purchasing tickets, in atoms"`
+	TicketMaxPrice int64  `long:"ticketmaxprice" description:"Maximum price to pay for a ticket, in atoms"`
+
+	HTTPMode          bool   `long:"http" description:"Run godcr as an http server instead of a command-line program"`
+	HTTPServerAddress string `long:"httpserveraddress" description:"Address for the http server to listen on" default:"127.0.0.1:7777"`
+
+	GRPCListen string `long:"grpclisten" description:"Address for the grpc server to listen on; grpc is only started if this is set"`
+
+	DesktopMode bool `long:"desktop" description:"Run godcr as a desktop application"`
+
+	CreateWallet   bool `long:"createwallet" description:"Create a new wallet before running the requested command"`
+	Restore        bool `long:"restore" description:"Restore a wallet from an existing seed instead of generating a new one; used with --createwallet"`
+	SyncBlockchain bool `long:"sync" description:"Sync the block chain before running the requested command"`
+
+	// Wallets holds the raw "name:kind:endpoint:cert" entries passed via --wallet.
+	// Use ParseWallets to turn these into WalletEntry values. When empty, godcr
+	// connects to a single wallet using the options above instead.
+	Wallets []string `long:"wallet" description:"Additional wallet to connect to, in the form name:kind:endpoint:cert (kind is dcrlibwallet or dcrwalletrpc); may be repeated"`
+}
+
+// WalletEntry is one parsed --wallet flag, describing a single wallet backend to
+// connect to alongside (or instead of) the default wallet.
+type WalletEntry struct {
+	Name     string
+	Kind     string // "dcrlibwallet" or "dcrwalletrpc"
+	Endpoint string // appdata dir for dcrlibwallet, rpc server address for dcrwalletrpc
+	Cert     string // rpc cert path; unused for dcrlibwallet
+}
+
+// defaultWalletName is reserved for the implicit wallet connectToWallets always adds
+// from the top-level config options; a --wallet entry can't reuse it.
+const defaultWalletName = "default"
+
+// ParseWallets parses the raw --wallet entries into WalletEntry values.
+func (cfg *Config) ParseWallets() ([]WalletEntry, error) {
+	entries := make([]WalletEntry, 0, len(cfg.Wallets))
+	seenNames := make(map[string]bool, len(cfg.Wallets))
+
+	for _, raw := range cfg.Wallets {
+		parts := strings.SplitN(raw, ":", 4)
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid --wallet entry %q, expected name:kind:endpoint[:cert]", raw)
+		}
+
+		entry := WalletEntry{Name: parts[0], Kind: parts[1], Endpoint: parts[2]}
+		if len(parts) == 4 {
+			entry.Cert = parts[3]
+		}
+
+		if entry.Kind != "dcrlibwallet" && entry.Kind != "dcrwalletrpc" {
+			return nil, fmt.Errorf("invalid --wallet kind %q for wallet %q, expected dcrlibwallet or dcrwalletrpc", entry.Kind, entry.Name)
+		}
+		if entry.Name == defaultWalletName {
+			return nil, fmt.Errorf(`--wallet name %q is reserved for the default wallet, choose a different name`, entry.Name)
+		}
+		if seenNames[entry.Name] {
+			return nil, fmt.Errorf("duplicate --wallet name %q", entry.Name)
+		}
+		seenNames[entry.Name] = true
+
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Default returns a Config populated with sensible defaults, before any config
+// file or command-line flags are applied.
+func Default() *Config {
+	return &Config{
+		AppDataDir:        defaultAppDataDir(),
+		NetworkType:       netparams.MainNetParams.Name,
+		HTTPServerAddress: "127.0.0.1:7777",
+	}
+}
+
+// LoadConfig builds a Config from defaults, then overlays values from the
+// command-line flags; no config file is read. The returned args are any non-flag
+// arguments left over after parsing, including a cli subcommand and its options.
+// parseCommands is not used: the parser only knows the global options either way.
+func LoadConfig(parseCommands bool) (args []string, cfg *Config, parser *flags.Parser, err error) {
+	cfg = Default()
+
+	options := flags.HelpFlag | flags.PassDoubleDash
+	parser = flags.NewParser(cfg, options)
+
+	args, err = parser.Parse()
+	if err != nil {
+		return args, cfg, parser, err
+	}
+
+	if _, err = netparams.Parse(cfg.NetworkType); err != nil {
+		return args, cfg, parser, fmt.Errorf("invalid --net value: %s", err.Error())
+	}
+
+	return args, cfg, parser, nil
+}
+
+// NetParams returns the netparams.Params matching this config's NetworkType.
+func (cfg *Config) NetParams() netparams.Params {
+	// NetworkType is validated in LoadConfig, so the error can be safely ignored here
+	params, _ := netparams.Parse(cfg.NetworkType)
+	return params
+}
+
+// IsFlagErrorType reports whether err is a go-flags error of the given type.
+func IsFlagErrorType(err error, errType flags.ErrorType) bool {
+	if flagsErr, ok := err.(*flags.Error); ok {
+		return flagsErr.Type == errType
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWallets(t *testing.T) {
+	cfg := &Config{
+		Wallets: []string{
+			"voting1:dcrwalletrpc:127.0.0.1:rpc.cert",
+			"watch:dcrlibwallet:/home/user/.godcr/watch",
+		},
+	}
+
+	entries, err := cfg.ParseWallets()
+	if err != nil {
+		t.Fatalf("ParseWallets returned unexpected error: %s", err)
+	}
+
+	want := []WalletEntry{
+		{Name: "voting1", Kind: "dcrwalletrpc", Endpoint: "127.0.0.1", Cert: "rpc.cert"},
+		{Name: "watch", Kind: "dcrlibwallet", Endpoint: "/home/user/.godcr/watch"},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("ParseWallets() = %+v, want %+v", entries, want)
+	}
+}
+
+func TestParseWalletsInvalidEntry(t *testing.T) {
+	cfg := &Config{Wallets: []string{"missingparts"}}
+	if _, err := cfg.ParseWallets(); err == nil {
+		t.Error("expected an error for a --wallet entry with too few parts")
+	}
+}
+
+func TestParseWalletsInvalidKind(t *testing.T) {
+	cfg := &Config{Wallets: []string{"name:notakind:endpoint"}}
+	if _, err := cfg.ParseWallets(); err == nil {
+		t.Error("expected an error for a --wallet entry with an unknown kind")
+	}
+}
+
+func TestParseWalletsReservedName(t *testing.T) {
+	cfg := &Config{Wallets: []string{"default:dcrlibwallet:/home/user/.godcr/default"}}
+	if _, err := cfg.ParseWallets(); err == nil {
+		t.Error(`expected an error for a --wallet entry named "default"`)
+	}
+}
+
+func TestParseWalletsDuplicateName(t *testing.T) {
+	cfg := &Config{
+		Wallets: []string{
+			"voting1:dcrwalletrpc:127.0.0.1:rpc.cert",
+			"voting1:dcrlibwallet:/home/user/.godcr/voting1",
+		},
+	}
+	if _, err := cfg.ParseWallets(); err == nil {
+		t.Error("expected an error for duplicate --wallet names")
+	}
+}
@@ -3,32 +3,23 @@ package main
 import (
 	"context"
 	"fmt"
-
+	"net"
 	"os"
-	"strings"
-
-	flags "github.com/jessevdk/go-flags"
-	"github.com/raedahgroup/godcr/cli"
-	"github.com/raedahgroup/godcr/config"
-	"github.com/raedahgroup/godcr/desktop"
-	ws "github.com/raedahgroup/godcr/walletsource"
-	"github.com/raedahgroup/godcr/walletsource/dcrwalletrpc"
-	"github.com/raedahgroup/godcr/walletsource/mobilewalletlib"
-	"github.com/raedahgroup/godcr/web"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 
+	flags "github.com/jessevdk/go-flags"
+
 	"github.com/raedahgroup/dcrcli/app"
-	"github.com/raedahgroup/dcrcli/app/config"
 	"github.com/raedahgroup/dcrcli/app/walletmediums/dcrlibwallet"
 	"github.com/raedahgroup/dcrcli/app/walletmediums/dcrwalletrpc"
 	"github.com/raedahgroup/dcrcli/cli"
+	"github.com/raedahgroup/dcrcli/config"
+	"github.com/raedahgroup/dcrcli/netparams"
+	"github.com/raedahgroup/dcrcli/rpc"
 	"github.com/raedahgroup/dcrcli/web"
-	"os"
-	"os/signal"
-	"sync"
-	"syscall"
 )
 
 // triggered after program execution is complete or if interrupt signal is received
@@ -44,23 +35,13 @@ func main() {
 	args, appConfig, parser, err := config.LoadConfig(true)
 	if err != nil {
 		handleParseError(err, parser)
-	appConfig := config.Default()
-
-	// create parser to parse flags/options from config and commands
-	parser := flags.NewParser(&commands.CliCommands{Config: appConfig}, flags.HelpFlag)
-
-	// continueExecution will be false if an error is encountered while parsing or if `-h` or `-v` is encountered
-	continueExecution := config.ParseConfig(appConfig, parser)
-	if !continueExecution {
-	appConfig := config.LoadConfig()
-	if appConfig == nil {
 		os.Exit(1)
 	}
 
 	// use wait group to keep main alive until shutdown completes
 	shutdownWaitGroup := &sync.WaitGroup{}
 
-	go listenForInterruptRequests()
+	go listenForShutdown()
 	go handleShutdown(shutdownWaitGroup)
 
 	// use ctx to monitor potentially long running operations
@@ -68,24 +49,49 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	shutdownOps = append(shutdownOps, cancel)
 
-	// open connection to wallet and add wallet close function to shutdownOps
-	walletMiddleware := connectToWallet(ctx, appConfig)
-	shutdownOps = append(shutdownOps, walletMiddleware.CloseWallet)
+	// open connections to every configured wallet and add a close-all function to
+	// shutdownOps; a wallet that fails to connect is warned about, not fatal
+	walletSet, failedConnections := connectToWallets(ctx, appConfig)
+	for _, failure := range failedConnections {
+		fmt.Println("warning: failed to connect to wallet:", failure.Error())
+	}
+	if walletSet.Len() == 0 {
+		fmt.Println("No wallets could be connected to")
+		os.Exit(1)
+	}
+	shutdownOps = append(shutdownOps, walletSet.CloseAll)
+
+	// the web and grpc entry points only drive a single wallet; they use the first
+	// successfully connected wallet, while cli.Run is given the whole wallet set
+	walletMiddleware := walletSet.All()[0].Middleware
+
+	// eventBus is the single source of truth for sync/transaction notifications,
+	// shared by the web websocket and the grpc sync-progress stream
+	eventBus := app.NewEventBus()
+
+	if appConfig.GRPCListen != "" {
+		go func() {
+			if err := rpc.StartGRPCServer(ctx, walletMiddleware, appConfig.GRPCListen, nil, eventBus); err != nil && ctx.Err() == nil {
+				fmt.Println("grpc server error:", err.Error())
+			}
+		}()
+	}
 
 	if appConfig.HTTPMode {
 		if len(args) > 0 {
 			fmt.Println("unexpected command or flag:", strings.Join(args, " "))
 			os.Exit(1)
 		}
-		opError = web.StartHttpServer(ctx, walletMiddleware, appConfig.HTTPServerAddress)
+		opError = web.StartHttpServer(ctx, walletMiddleware, appConfig.HTTPServerAddress, eventBus)
 		// only trigger shutdown if some error occurred, ctx.Err cases would already have triggered shutdown, so ignore
 		if opError != nil && ctx.Err() == nil {
 			beginShutdown <- true
 		}
 	} else if appConfig.DesktopMode {
-		enterDesktopMode(wallet)
+		fmt.Println("Desktop mode is not available in this build")
+		os.Exit(1)
 	} else {
-		opError = cli.Run(ctx, walletMiddleware, appConfig)
+		opError = cli.Run(ctx, walletSet, appConfig, args, eventBus)
 		// cli run done, trigger shutdown
 		beginShutdown <- true
 	}
@@ -94,95 +100,93 @@ func main() {
 	shutdownWaitGroup.Wait()
 }
 
-// connectToWallet opens connection to a wallet via any of the available walletmiddleware
-// default walletmiddleware is dcrlibwallet, alternative is dcrwalletrpc
-func connectToWallet(ctx context.Context, config *config.Config) app.WalletMiddleware {
-	var netType string
-	if config.UseTestNet {
-		netType = "testnet"
-	} else {
-		netType = "mainnet"
+// connectToWallet opens connection to a single wallet via any of the available
+// walletmiddleware. default walletmiddleware is dcrlibwallet, alternative is dcrwalletrpc.
+// params supplies the network-specific rpc port/cert defaults so every frontend
+// dials a dcrwalletrpc endpoint the same way.
+func connectToWallet(ctx context.Context, params netparams.Params, entry config.WalletEntry, useWalletRPC bool, noWalletRPCTLS bool) (app.WalletMiddleware, error) {
+	if entry.Kind == "dcrlibwallet" || (entry.Kind == "" && !useWalletRPC) {
+		return dcrlibwallet.New(entry.Endpoint, params.Name), nil
 	}
 
-	if !config.UseWalletRPC {
-		return dcrlibwallet.New(config.AppDataDir, netType)
+	endpoint := entry.Endpoint
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		endpoint = net.JoinHostPort(endpoint, params.GRPCClientPort)
 	}
+	cert := entry.Cert
+	if cert == "" {
+		cert = params.DefaultRPCCertFile
+	}
+
+	return dcrwalletrpc.New(ctx, params.Name, endpoint, cert, noWalletRPCTLS)
+}
 
-	walletMiddleware, err := dcrwalletrpc.New(ctx, netType, config.WalletRPCServer, config.WalletRPCCert, config.NoWalletRPCTLS)
+// connectToWallets dials every wallet godcr has been configured to use: the default
+// wallet described by the top-level config options, plus one per repeatable --wallet
+// entry. A wallet that fails to connect is logged and skipped rather than aborting
+// the whole program, matching vspd's Wallets.Clients pattern.
+func connectToWallets(ctx context.Context, cfg *config.Config) (*app.WalletSet, []error) {
+	params := cfg.NetParams()
+
+	entries := []config.WalletEntry{{Name: "default", Endpoint: cfg.AppDataDir}}
+	extraEntries, err := cfg.ParseWallets()
 	if err != nil {
-		fmt.Println("Connect to dcrwallet rpc failed")
 		fmt.Println(err.Error())
 		os.Exit(1)
 	}
+	entries = append(entries, extraEntries...)
 
-	return walletMiddleware
-}
+	walletSet := app.NewWalletSet()
+	var failedConnections []error
 
-func enterDesktopMode(walletsource ws.WalletSource) {
-	fmt.Println("Running in desktop mode")
-	desktop.StartDesktopApp(walletsource)
-}
+	for _, entry := range entries {
+		if entry.Kind == "" {
+			if cfg.UseWalletRPC {
+				entry.Kind = "dcrwalletrpc"
+				entry.Endpoint = cfg.WalletRPCServer
+				entry.Cert = cfg.WalletRPCCert
+			} else {
+				entry.Kind = "dcrlibwallet"
+			}
+		}
 
-func enterCliMode(appConfig *config.Config, wallet core.Wallet) {
-	// todo: correct comment Set the walletrpcclient.Client object that will be used by the command handlers
-	cli.Wallet = wallet
-
-	parser := flags.NewParser(appConfig, flags.HelpFlag|flags.PassDoubleDash)
-	if _, err := parser.Parse(); err != nil {
-		if config.IsFlagErrorType(err, flags.ErrCommandRequired) {
-			// No command was specified, print the available commands.
-			availableCommands := supportedCommands(parser)
-			fmt.Fprintln(os.Stderr, "Available Commands: ", strings.Join(availableCommands, ", "))
-		} else {
-			handleParseError(err, parser)
+		walletMiddleware, err := connectToWallet(ctx, params, entry, cfg.UseWalletRPC, cfg.NoWalletRPCTLS)
+		if err != nil {
+			failedConnections = append(failedConnections, fmt.Errorf("%s: %s", entry.Name, err.Error()))
+			continue
 		}
-		os.Exit(1)
-	}
-}
 
-func enterCliMode(appConfig config.Config, walletsource ws.WalletSource) {
-	cli.WalletSource = walletsource
+		if cfg.EnableVoting {
+			enableTicketBuyer(walletMiddleware, cfg)
+		}
 
-	if appConfig.CreateWallet {
-		// perform first blockchain sync after creating wallet
-		cli.CreateWallet()
-		appConfig.SyncBlockchain = true
+		if err := walletSet.Add(entry.Name, walletMiddleware); err != nil {
+			failedConnections = append(failedConnections, err)
+			continue
+		}
 	}
 
-	if appConfig.SyncBlockchain {
-		// open wallet then sync blockchain, before executing command
-		cli.OpenWallet()
-		cli.SyncBlockChain()
-	}
+	return walletSet, failedConnections
+}
 
-	appRoot := cli.Root{Config: appConfig}
-	parser := flags.NewParser(&appRoot, flags.HelpFlag|flags.PassDoubleDash)
-	parser.CommandHandler = cli.CommandHandlerWrapper(parser, client)
-	if _, err := parser.Parse(); err != nil {
-		if config.IsFlagErrorType(err, flags.ErrCommandRequired) {
-			// No command was specified, print the available commands.
-			var availableCommands []string
-			if parser.Active != nil {
-				availableCommands = supportedCommands(parser.Active)
-			} else {
-				availableCommands = supportedCommands(parser.Command)
-			}
-			fmt.Fprintln(os.Stderr, "Available Commands: ", strings.Join(availableCommands, ", "))
-		} else {
-			handleParseError(err, parser)
-		}
-		os.Exit(1)
+// enableTicketBuyer turns on automatic ticket purchasing for walletMiddleware using the
+// voting-related options from config, if the connected wallet backend supports staking.
+func enableTicketBuyer(walletMiddleware app.WalletMiddleware, config *config.Config) {
+	stakingMiddleware, ok := walletMiddleware.(app.StakingMiddleware)
+	if !ok {
+		fmt.Println("--enablevoting was set but the connected wallet does not support staking")
+		return
 	}
-}
 
-func supportedCommands(parser *flags.Command) []string {
-	registeredCommands := parser.Commands()
-	commandNames := make([]string, 0, len(registeredCommands))
-	for _, command := range registeredCommands {
-		commandNames = append(commandNames, command.Name)
+	options := app.StakeOptions{
+		TicketFee:      config.TicketFee,
+		TicketMaxPrice: config.TicketMaxPrice,
+		VSPHost:        config.VSPHost,
+		VSPPubKey:      config.VSPCert,
+	}
+	if err := stakingMiddleware.EnableTicketBuyer(options); err != nil {
+		fmt.Println("Failed to enable ticket buyer:", err.Error())
 	}
-	sort.Strings(commandNames)
-	return commandNames
 }
 
 func handleParseError(err error, parser *flags.Parser) {
@@ -0,0 +1,69 @@
+// Package netparams centralizes the per-network settings (ports, default rpc
+// certs) that the cli, web and desktop frontends need to agree on when dialing
+// dcrd/dcrwallet, mirroring btcwallet's netparams package.
+package netparams
+
+import "fmt"
+
+// Params holds the settings specific to one Decred network.
+type Params struct {
+	Name string
+
+	// JSONRPCClientPort is the default port dcrwallet's json-rpc server listens on.
+	JSONRPCClientPort string
+	// GRPCClientPort is the default port dcrwallet's grpc server listens on.
+	GRPCClientPort string
+
+	DefaultRPCCertFile string
+}
+
+// MainNetParams are the parameters for the main Decred network.
+var MainNetParams = Params{
+	Name:               "mainnet",
+	JSONRPCClientPort:  "9109",
+	GRPCClientPort:     "9111",
+	DefaultRPCCertFile: "rpc.cert",
+}
+
+// TestNetParams are the parameters for the test Decred network (testnet3).
+var TestNetParams = Params{
+	Name:               "testnet",
+	JSONRPCClientPort:  "19109",
+	GRPCClientPort:     "19111",
+	DefaultRPCCertFile: "rpc.cert",
+}
+
+// SimNetParams are the parameters for the simulation Decred network, used for
+// local development against a private dcrd/dcrwallet instance.
+var SimNetParams = Params{
+	Name:               "simnet",
+	JSONRPCClientPort:  "19556",
+	GRPCClientPort:     "19558",
+	DefaultRPCCertFile: "rpc.cert",
+}
+
+// RegNetParams are the parameters for the regression test Decred network.
+var RegNetParams = Params{
+	Name:               "regtest",
+	JSONRPCClientPort:  "18556",
+	GRPCClientPort:     "18558",
+	DefaultRPCCertFile: "rpc.cert",
+}
+
+// byName indexes the known network params by their config/cli name.
+var byName = map[string]Params{
+	MainNetParams.Name: MainNetParams,
+	TestNetParams.Name: TestNetParams,
+	SimNetParams.Name:  SimNetParams,
+	RegNetParams.Name:  RegNetParams,
+}
+
+// Parse returns the Params registered under netType, or an error if netType is
+// not one of mainnet, testnet, simnet or regtest.
+func Parse(netType string) (Params, error) {
+	params, ok := byName[netType]
+	if !ok {
+		return Params{}, fmt.Errorf("unknown network %q, expected one of mainnet, testnet, simnet, regtest", netType)
+	}
+	return params, nil
+}
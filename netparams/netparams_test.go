@@ -0,0 +1,32 @@
+package netparams
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		netType string
+		want    Params
+	}{
+		{"mainnet", MainNetParams},
+		{"testnet", TestNetParams},
+		{"simnet", SimNetParams},
+		{"regtest", RegNetParams},
+	}
+
+	for _, test := range tests {
+		got, err := Parse(test.netType)
+		if err != nil {
+			t.Errorf("Parse(%q) returned unexpected error: %s", test.netType, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", test.netType, got, test.want)
+		}
+	}
+}
+
+func TestParseUnknownNetwork(t *testing.T) {
+	if _, err := Parse("not-a-network"); err == nil {
+		t.Error("expected Parse to return an error for an unknown network")
+	}
+}
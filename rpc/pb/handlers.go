@@ -0,0 +1,94 @@
+package pb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+func versionHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Version(in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/rpc.WalletService/Version"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Version(req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func balanceHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).Balance(in)
+}
+
+func accountsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).Accounts(in)
+}
+
+func sendHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).Send(in)
+}
+
+func receiveHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).Receive(in)
+}
+
+func historyHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).History(in)
+}
+
+func purchaseTicketsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).PurchaseTickets(in)
+}
+
+func ticketsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(WalletServiceServer).Tickets(in)
+}
+
+func syncProgressHandler(srv interface{}, stream grpc.ServerStream) error {
+	in := new(SyncProgressRequest)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).SyncProgress(in, &walletServiceSyncProgressServer{stream})
+}
+
+type walletServiceSyncProgressServer struct {
+	grpc.ServerStream
+}
+
+func (s *walletServiceSyncProgressServer) Send(resp *SyncProgressResponse) error {
+	return s.ServerStream.SendMsg(resp)
+}
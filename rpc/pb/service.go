@@ -0,0 +1,47 @@
+package pb
+
+import (
+	"google.golang.org/grpc"
+)
+
+// WalletServiceServer is the server api for WalletService, as described in api.proto.
+type WalletServiceServer interface {
+	Version(*VersionRequest) (*VersionResponse, error)
+	Balance(*BalanceRequest) (*BalanceResponse, error)
+	Accounts(*AccountsRequest) (*AccountsResponse, error)
+	Send(*SendRequest) (*SendResponse, error)
+	Receive(*ReceiveRequest) (*ReceiveResponse, error)
+	History(*HistoryRequest) (*HistoryResponse, error)
+	SyncProgress(*SyncProgressRequest, WalletService_SyncProgressServer) error
+	PurchaseTickets(*PurchaseTicketsRequest) (*PurchaseTicketsResponse, error)
+	Tickets(*TicketsRequest) (*TicketsResponse, error)
+}
+
+// WalletService_SyncProgressServer is the server-side stream for the SyncProgress rpc.
+type WalletService_SyncProgressServer interface {
+	Send(*SyncProgressResponse) error
+	grpc.ServerStream
+}
+
+// RegisterWalletServiceServer registers srv to handle WalletService rpcs on s.
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&walletServiceDesc, srv)
+}
+
+var walletServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpc.WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Version", Handler: versionHandler},
+		{MethodName: "Balance", Handler: balanceHandler},
+		{MethodName: "Accounts", Handler: accountsHandler},
+		{MethodName: "Send", Handler: sendHandler},
+		{MethodName: "Receive", Handler: receiveHandler},
+		{MethodName: "History", Handler: historyHandler},
+		{MethodName: "PurchaseTickets", Handler: purchaseTicketsHandler},
+		{MethodName: "Tickets", Handler: ticketsHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "SyncProgress", Handler: syncProgressHandler, ServerStreams: true},
+	},
+}
@@ -0,0 +1,198 @@
+// Package pb contains the request/response types described in rpc/api.proto,
+// together with the Reset/String/ProtoMessage methods the proto3 wire codec
+// needs to marshal them.
+//
+// These would normally come entirely from protoc-gen-go; until that's wired up,
+// they're hand-written in the same shape (including the protobuf struct tags),
+// which is enough for encoding/proto's reflection-based codec to marshal and
+// unmarshal them correctly over the wire.
+package pb
+
+import "fmt"
+
+type VersionRequest struct{}
+
+func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
+func (m *VersionRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *VersionRequest) ProtoMessage()  {}
+
+type VersionResponse struct {
+	Major uint32 `protobuf:"varint,1,opt,name=major,proto3"`
+	Minor uint32 `protobuf:"varint,2,opt,name=minor,proto3"`
+	Patch uint32 `protobuf:"varint,3,opt,name=patch,proto3"`
+}
+
+func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
+func (m *VersionResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *VersionResponse) ProtoMessage()  {}
+
+type BalanceRequest struct {
+	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3"`
+}
+
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *BalanceRequest) ProtoMessage()  {}
+
+type BalanceResponse struct {
+	Total     int64 `protobuf:"varint,1,opt,name=total,proto3"`
+	Spendable int64 `protobuf:"varint,2,opt,name=spendable,proto3"`
+}
+
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *BalanceResponse) ProtoMessage()  {}
+
+type AccountsRequest struct{}
+
+func (m *AccountsRequest) Reset()         { *m = AccountsRequest{} }
+func (m *AccountsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *AccountsRequest) ProtoMessage()  {}
+
+type Account struct {
+	Number  uint32 `protobuf:"varint,1,opt,name=number,proto3"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3"`
+	Balance int64  `protobuf:"varint,3,opt,name=balance,proto3"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *Account) ProtoMessage()  {}
+
+type AccountsResponse struct {
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3"`
+}
+
+func (m *AccountsResponse) Reset()         { *m = AccountsResponse{} }
+func (m *AccountsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *AccountsResponse) ProtoMessage()  {}
+
+type SendRequest struct {
+	SourceAccount      uint32 `protobuf:"varint,1,opt,name=source_account,json=sourceAccount,proto3"`
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3"`
+	Amount             int64  `protobuf:"varint,3,opt,name=amount,proto3"`
+	Passphrase         string `protobuf:"bytes,4,opt,name=passphrase,proto3"`
+}
+
+func (m *SendRequest) Reset()         { *m = SendRequest{} }
+func (m *SendRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *SendRequest) ProtoMessage()  {}
+
+type SendResponse struct {
+	TransactionHash string `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3"`
+}
+
+func (m *SendResponse) Reset()         { *m = SendResponse{} }
+func (m *SendResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *SendResponse) ProtoMessage()  {}
+
+type ReceiveRequest struct {
+	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3"`
+}
+
+func (m *ReceiveRequest) Reset()         { *m = ReceiveRequest{} }
+func (m *ReceiveRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *ReceiveRequest) ProtoMessage()  {}
+
+type ReceiveResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3"`
+}
+
+func (m *ReceiveResponse) Reset()         { *m = ReceiveResponse{} }
+func (m *ReceiveResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *ReceiveResponse) ProtoMessage()  {}
+
+type HistoryRequest struct{}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *HistoryRequest) ProtoMessage()  {}
+
+type Transaction struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3"`
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3"`
+	Amount    int64  `protobuf:"varint,4,opt,name=amount,proto3"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *Transaction) ProtoMessage()  {}
+
+type HistoryResponse struct {
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3"`
+}
+
+func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
+func (m *HistoryResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *HistoryResponse) ProtoMessage()  {}
+
+type SyncProgressRequest struct{}
+
+func (m *SyncProgressRequest) Reset()         { *m = SyncProgressRequest{} }
+func (m *SyncProgressRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *SyncProgressRequest) ProtoMessage()  {}
+
+// SyncStage identifies which of the sync listener's callbacks a SyncProgressResponse
+// was produced from.
+type SyncStage int32
+
+const (
+	HeadersFetched SyncStage = iota
+	DiscoveredAddress
+	RescanningBlocks
+)
+
+type SyncProgressResponse struct {
+	Stage              SyncStage `protobuf:"varint,1,opt,name=stage,proto3,enum=rpc.SyncProgressResponse_Stage"`
+	PercentageProgress int64     `protobuf:"varint,2,opt,name=percentage_progress,json=percentageProgress,proto3"`
+	State              string    `protobuf:"bytes,3,opt,name=state,proto3"`
+}
+
+func (m *SyncProgressResponse) Reset()         { *m = SyncProgressResponse{} }
+func (m *SyncProgressResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *SyncProgressResponse) ProtoMessage()  {}
+
+type PurchaseTicketsRequest struct {
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3"`
+	MaxPrice   int64  `protobuf:"varint,2,opt,name=max_price,json=maxPrice,proto3"`
+	NumTickets int32  `protobuf:"varint,3,opt,name=num_tickets,json=numTickets,proto3"`
+}
+
+func (m *PurchaseTicketsRequest) Reset()         { *m = PurchaseTicketsRequest{} }
+func (m *PurchaseTicketsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *PurchaseTicketsRequest) ProtoMessage()  {}
+
+type PurchaseTicketsResponse struct {
+	TicketHashes []string `protobuf:"bytes,1,rep,name=ticket_hashes,json=ticketHashes,proto3"`
+}
+
+func (m *PurchaseTicketsResponse) Reset()         { *m = PurchaseTicketsResponse{} }
+func (m *PurchaseTicketsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *PurchaseTicketsResponse) ProtoMessage()  {}
+
+type TicketsRequest struct{}
+
+func (m *TicketsRequest) Reset()         { *m = TicketsRequest{} }
+func (m *TicketsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *TicketsRequest) ProtoMessage()  {}
+
+type Ticket struct {
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3"`
+	Price  int64  `protobuf:"varint,3,opt,name=price,proto3"`
+	Fee    int64  `protobuf:"varint,4,opt,name=fee,proto3"`
+}
+
+func (m *Ticket) Reset()         { *m = Ticket{} }
+func (m *Ticket) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *Ticket) ProtoMessage()  {}
+
+type TicketsResponse struct {
+	Tickets []*Ticket `protobuf:"bytes,1,rep,name=tickets,proto3"`
+}
+
+func (m *TicketsResponse) Reset()         { *m = TicketsResponse{} }
+func (m *TicketsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (m *TicketsResponse) ProtoMessage()  {}
@@ -0,0 +1,10 @@
+package rpc
+
+// Protocol version advertised by the Version rpc, bumped whenever the wire api
+// in api.proto changes. Clients use this to refuse to talk to an incompatible
+// server rather than failing in more confusing ways further down the line.
+const (
+	semverMajor = 1
+	semverMinor = 0
+	semverPatch = 0
+)
@@ -0,0 +1,156 @@
+// Package rpc implements godcr's grpc api, as an alternative to the web
+// package's http interface for remote clients that want a typed, streaming api
+// instead of polling endpoints.
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/raedahgroup/dcrcli/app"
+	"github.com/raedahgroup/dcrcli/rpc/pb"
+)
+
+// walletServer implements pb.WalletServiceServer on top of a WalletMiddleware.
+type walletServer struct {
+	ctx              context.Context
+	walletMiddleware app.WalletMiddleware
+	eventBus         *app.EventBus
+}
+
+// StartGRPCServer starts godcr's grpc server on addr, blocking until ctx is
+// cancelled or the server encounters a fatal error. tlsCfg may be nil to serve
+// without transport security, e.g. for local development. eventBus is the same bus
+// passed to web.StartHttpServer/cli.Run, so SyncProgress streams whichever sync
+// those are already driving instead of starting a second one of its own.
+func StartGRPCServer(ctx context.Context, walletMiddleware app.WalletMiddleware, addr string, tlsCfg *tls.Config, eventBus *app.EventBus) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("grpc listen failed: %s", err.Error())
+	}
+
+	var opts []grpc.ServerOption
+	if tlsCfg != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterWalletServiceServer(grpcServer, &walletServer{ctx: ctx, walletMiddleware: walletMiddleware, eventBus: eventBus})
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	return grpcServer.Serve(listener)
+}
+
+// Version reports the server's semver so clients can refuse to talk to an
+// incompatible server before issuing any other rpc.
+func (s *walletServer) Version(*pb.VersionRequest) (*pb.VersionResponse, error) {
+	return &pb.VersionResponse{Major: semverMajor, Minor: semverMinor, Patch: semverPatch}, nil
+}
+
+func (s *walletServer) Balance(*pb.BalanceRequest) (*pb.BalanceResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *walletServer) Accounts(*pb.AccountsRequest) (*pb.AccountsResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *walletServer) Send(*pb.SendRequest) (*pb.SendResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *walletServer) Receive(*pb.ReceiveRequest) (*pb.ReceiveResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *walletServer) History(*pb.HistoryRequest) (*pb.HistoryResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// SyncProgress streams sync updates to the client as they're published on s.eventBus,
+// rather than making the client poll or starting a second, independent sync of its own.
+func (s *walletServer) SyncProgress(req *pb.SyncProgressRequest, stream pb.WalletService_SyncProgressServer) error {
+	sub := s.eventBus.Subscribe()
+	defer s.eventBus.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case event, ok := <-sub:
+			if !ok {
+				return nil
+			}
+			progress, ok := event.Data.(app.SyncProgressEvent)
+			if event.Type != app.EventSyncProgress || !ok {
+				continue
+			}
+
+			resp := &pb.SyncProgressResponse{PercentageProgress: progress.PercentageProgress, State: progress.State}
+			switch progress.Stage {
+			case "headers_fetched":
+				resp.Stage = pb.HeadersFetched
+			case "discovered_address":
+				resp.Stage = pb.DiscoveredAddress
+			case "rescanning_blocks":
+				resp.Stage = pb.RescanningBlocks
+			default:
+				continue
+			}
+
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *walletServer) stakingMiddleware() (app.StakingMiddleware, error) {
+	stakingMiddleware, ok := s.walletMiddleware.(app.StakingMiddleware)
+	if !ok {
+		return nil, fmt.Errorf("connected wallet does not support staking")
+	}
+	return stakingMiddleware, nil
+}
+
+func (s *walletServer) PurchaseTickets(req *pb.PurchaseTicketsRequest) (*pb.PurchaseTicketsResponse, error) {
+	stakingMiddleware, err := s.stakingMiddleware()
+	if err != nil {
+		return nil, err
+	}
+
+	options := app.StakeOptions{TicketMaxPrice: req.MaxPrice}
+	ticketHashes, err := stakingMiddleware.PurchaseTickets(req.Passphrase, options, int(req.NumTickets))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PurchaseTicketsResponse{TicketHashes: ticketHashes}, nil
+}
+
+func (s *walletServer) Tickets(*pb.TicketsRequest) (*pb.TicketsResponse, error) {
+	stakingMiddleware, err := s.stakingMiddleware()
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := stakingMiddleware.Tickets()
+	if err != nil {
+		return nil, err
+	}
+
+	pbTickets := make([]*pb.Ticket, len(tickets))
+	for i, ticket := range tickets {
+		pbTickets[i] = &pb.Ticket{Hash: ticket.Hash, Status: ticket.Status, Price: ticket.Price, Fee: ticket.Fee}
+	}
+	return &pb.TicketsResponse{Tickets: pbTickets}, nil
+}
@@ -0,0 +1,154 @@
+// Package walletrpcclient adapts an app.WalletSet into the narrower,
+// command-shaped API that cli/commands needs, so individual commands don't each
+// have to know about the underlying wallet backend(s).
+package walletrpcclient
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// Client wraps a WalletSet and exposes the operations used by cli commands.
+type Client struct {
+	walletSet *app.WalletSet
+}
+
+// New creates a Client that serves commands using a single wallet, registered
+// under the name "default".
+func New(walletMiddleware app.WalletMiddleware) *Client {
+	walletSet := app.NewWalletSet()
+	walletSet.Add("default", walletMiddleware)
+	return NewFromWalletSet(walletSet)
+}
+
+// NewFromWalletSet creates a Client that can aggregate results across every
+// wallet in walletSet.
+func NewFromWalletSet(walletSet *app.WalletSet) *Client {
+	return &Client{walletSet: walletSet}
+}
+
+// Transaction describes a single wallet transaction for display in the history command.
+type Transaction struct {
+	WalletName    string
+	FormattedTime string
+	Amount        string
+	Direction     string
+	Hash          string
+	Type          string
+}
+
+// GetTransactions returns the transaction history of every wallet in the set,
+// tagged with the wallet each transaction belongs to.
+func (c *Client) GetTransactions() ([]Transaction, error) {
+	var transactions []Transaction
+	for _, wallet := range c.walletSet.All() {
+		walletTransactions, err := transactionsForWallet(wallet.Middleware)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", wallet.Name, err.Error())
+		}
+		for i := range walletTransactions {
+			walletTransactions[i].WalletName = wallet.Name
+		}
+		transactions = append(transactions, walletTransactions...)
+	}
+	return transactions, nil
+}
+
+// transactionsForWallet is a stub pending a WalletMiddleware method to list
+// transactions; no backend (dcrlibwallet or dcrwalletrpc) exposes one yet.
+func transactionsForWallet(walletMiddleware app.WalletMiddleware) ([]Transaction, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// ReceiveResult holds the address generated by a receive command.
+type ReceiveResult struct {
+	Address string
+}
+
+// AccountNumber returns the account number corresponding to accountName on the
+// named wallet (walletName may be empty to use the default wallet). This is a stub
+// pending a WalletMiddleware method to look up accounts by name; no backend exposes
+// one yet.
+func (c *Client) AccountNumber(walletName, accountName string) (uint32, error) {
+	if _, err := c.walletByName(walletName); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("not implemented")
+}
+
+// Receive generates a new receiving address for the given account on the default
+// wallet. This is a stub pending a WalletMiddleware method to generate addresses;
+// no backend exposes one yet.
+func (c *Client) Receive(accountNumber uint32) (*ReceiveResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// SendFromWallet sends amount atoms from sourceAccount on the named wallet to
+// destinationAddress. walletName may be empty to use the default wallet.
+func (c *Client) SendFromWallet(walletName string, sourceAccount uint32, destinationAddress string, amount int64, passphrase string) (string, error) {
+	walletMiddleware, err := c.walletByName(walletName)
+	if err != nil {
+		return "", err
+	}
+	return walletMiddleware.Send(sourceAccount, destinationAddress, amount, passphrase)
+}
+
+// Balance describes one wallet's account balance for display in the balance command.
+type Balance struct {
+	WalletName string
+	Total      int64
+	Spendable  int64
+}
+
+// GetBalances returns the default account's balance of every wallet in the set,
+// tagged with the wallet each balance belongs to.
+func (c *Client) GetBalances() ([]Balance, error) {
+	var balances []Balance
+	for _, wallet := range c.walletSet.All() {
+		total, spendable, err := wallet.Middleware.Balance(0)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", wallet.Name, err.Error())
+		}
+		balances = append(balances, Balance{WalletName: wallet.Name, Total: total, Spendable: spendable})
+	}
+	return balances, nil
+}
+
+// walletByName returns the named wallet, or the default (first-added) wallet if
+// walletName is empty, for commands that haven't grown --fromwallet support of
+// their own.
+func (c *Client) walletByName(walletName string) (app.WalletMiddleware, error) {
+	if walletName == "" {
+		return c.primaryWallet()
+	}
+	walletMiddleware, ok := c.walletSet.Get(walletName)
+	if !ok {
+		return nil, fmt.Errorf("no such wallet: %s", walletName)
+	}
+	return walletMiddleware, nil
+}
+
+// primaryWallet returns the default (first-added) wallet in the set, for commands
+// that haven't grown --fromwallet support of their own.
+func (c *Client) primaryWallet() (app.WalletMiddleware, error) {
+	wallets := c.walletSet.All()
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("no wallet connected")
+	}
+	return wallets[0].Middleware, nil
+}
+
+// stakingMiddleware returns the default wallet as a StakingMiddleware, if the
+// connected wallet backend supports staking.
+func (c *Client) stakingMiddleware() (app.StakingMiddleware, error) {
+	walletMiddleware, err := c.primaryWallet()
+	if err != nil {
+		return nil, err
+	}
+	stakingMiddleware, ok := walletMiddleware.(app.StakingMiddleware)
+	if !ok {
+		return nil, fmt.Errorf("connected wallet does not support staking")
+	}
+	return stakingMiddleware, nil
+}
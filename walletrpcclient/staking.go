@@ -0,0 +1,58 @@
+package walletrpcclient
+
+import "github.com/raedahgroup/dcrcli/app"
+
+// PurchaseTickets buys numTickets tickets for the wallet, using its current stake options.
+func (c *Client) PurchaseTickets(passphrase string, options app.StakeOptions, numTickets int) ([]string, error) {
+	stakingMiddleware, err := c.stakingMiddleware()
+	if err != nil {
+		return nil, err
+	}
+	return stakingMiddleware.PurchaseTickets(passphrase, options, numTickets)
+}
+
+// GetTickets returns all tickets known to the wallet.
+func (c *Client) GetTickets() ([]app.Ticket, error) {
+	stakingMiddleware, err := c.stakingMiddleware()
+	if err != nil {
+		return nil, err
+	}
+	return stakingMiddleware.Tickets()
+}
+
+// SetVoteBits updates the vote bits the wallet will use when voting on a ticket.
+func (c *Client) SetVoteBits(voteBits uint16) error {
+	stakingMiddleware, err := c.stakingMiddleware()
+	if err != nil {
+		return err
+	}
+	return stakingMiddleware.SetVoteBits(voteBits)
+}
+
+// SetTicketMaxPrice updates the maximum price the wallet will pay for a ticket.
+func (c *Client) SetTicketMaxPrice(maxPrice int64) error {
+	stakingMiddleware, err := c.stakingMiddleware()
+	if err != nil {
+		return err
+	}
+	return stakingMiddleware.SetTicketMaxPrice(maxPrice)
+}
+
+// RevokeTicket issues a revocation for a missed or expired ticket.
+func (c *Client) RevokeTicket(ticketHash string) error {
+	stakingMiddleware, err := c.stakingMiddleware()
+	if err != nil {
+		return err
+	}
+	return stakingMiddleware.RevokeTicket(ticketHash)
+}
+
+// SubscribeToTicketLifecycle registers listener to be notified of winning and missed
+// tickets. It is a no-op if the connected wallet does not support staking.
+func (c *Client) SubscribeToTicketLifecycle(listener *app.TicketLifecycleListener) {
+	stakingMiddleware, err := c.stakingMiddleware()
+	if err != nil {
+		return
+	}
+	stakingMiddleware.SubscribeToTicketLifecycle(listener)
+}
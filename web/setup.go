@@ -0,0 +1,82 @@
+package web
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// setupHandler renders the initial wallet setup page, letting the user choose between
+// creating a new wallet and restoring an existing one from its seed.
+func (s *server) setupHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		s.handleSetupSubmit(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, `<html><body><h1>Set up your wallet</h1>
+<form method="post">
+<label><input type="radio" name="mode" value="create" checked> Create a new wallet</label><br>
+<label><input type="radio" name="mode" value="restore"> Restore an existing wallet from seed</label><br>
+<input type="password" name="passphrase" placeholder="Private passphrase"><br>
+<textarea name="seed" placeholder="Seed (only needed to restore)"></textarea><br>
+<button type="submit">Continue</button>
+</form></body></html>`)
+}
+
+// handleSetupSubmit creates or restores the wallet based on the submitted setup form.
+// Creating a new wallet is a two-step exchange: the first submission only generates
+// the seed and hands it back to the browser for the user to back up, mirroring the
+// cli's forced seed-backup confirmation; the wallet isn't actually created until the
+// user resubmits the form with that seed and an explicit backup confirmation.
+func (s *server) handleSetupSubmit(w http.ResponseWriter, r *http.Request) {
+	passphrase := r.FormValue("passphrase")
+
+	if r.FormValue("mode") == "restore" {
+		if err := s.walletMiddleware.RestoreWallet(passphrase, r.FormValue("seed"), 0); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, "Wallet ready")
+		return
+	}
+
+	seed := r.FormValue("seed")
+	if seed == "" {
+		seed, err := s.walletMiddleware.GenerateNewWalletSeed()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		renderSeedBackupPage(w, passphrase, seed)
+		return
+	}
+
+	if r.FormValue("backupconfirmed") != "yes" {
+		http.Error(w, "you must confirm you have backed up the seed before the wallet can be created", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.walletMiddleware.CreateWallet(passphrase, seed); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprint(w, "Wallet ready")
+}
+
+// renderSeedBackupPage shows a freshly generated seed to the user and requires them
+// to confirm they've backed it up before resubmitting to actually create the wallet.
+func renderSeedBackupPage(w http.ResponseWriter, passphrase, seed string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, `<html><body><h1>Back up your new wallet seed</h1>
+<p>Write down the seed below and store it somewhere safe. It is the only way to recover your wallet if this device is lost.</p>
+<pre>%s</pre>
+<form method="post">
+<input type="hidden" name="mode" value="create">
+<input type="hidden" name="passphrase" value="%s">
+<input type="hidden" name="seed" value="%s">
+<label><input type="checkbox" name="backupconfirmed" value="yes" required> I have backed up this seed</label><br>
+<button type="submit">Create wallet</button>
+</form></body></html>`, html.EscapeString(seed), html.EscapeString(passphrase), html.EscapeString(seed))
+}
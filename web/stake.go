@@ -0,0 +1,21 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// renderTicketsPage writes a minimal html table of the wallet's tickets and their status.
+func renderTicketsPage(w http.ResponseWriter, tickets []app.Ticket) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	fmt.Fprint(w, "<html><body><h1>Tickets</h1><table border=\"1\">")
+	fmt.Fprint(w, "<tr><th>Hash</th><th>Status</th><th>Price</th><th>Fee</th></tr>")
+	for _, ticket := range tickets {
+		fmt.Fprintf(w, "<tr><td>%s</td><td>%s</td><td>%d</td><td>%d</td></tr>",
+			ticket.Hash, ticket.Status, ticket.Price, ticket.Fee)
+	}
+	fmt.Fprint(w, "</table></body></html>")
+}
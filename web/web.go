@@ -0,0 +1,110 @@
+// Package web serves godcr's http/websocket frontend, for driving a wallet from
+// a browser instead of the terminal.
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/raedahgroup/dcrcli/app"
+)
+
+// server holds the dependencies shared by the various http handlers.
+type server struct {
+	ctx              context.Context
+	walletMiddleware app.WalletMiddleware
+	eventBus         *app.EventBus
+}
+
+// StartHttpServer starts godcr's http server, blocking until ctx is cancelled or
+// the server encounters a fatal error. Sync progress and new-transaction events are
+// published to eventBus as they happen, and fanned out to every browser connected to
+// /ws; pass the same eventBus to rpc.StartGRPCServer so both frontends share one
+// source of truth.
+func StartHttpServer(ctx context.Context, walletMiddleware app.WalletMiddleware, httpServerAddress string, eventBus *app.EventBus) error {
+	s := &server{ctx: ctx, walletMiddleware: walletMiddleware, eventBus: eventBus}
+
+	go s.publishSyncProgress()
+	go s.publishNewTransactions()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/setup", s.setupHandler)
+	mux.HandleFunc("/stake", s.stakeHandler)
+	mux.HandleFunc("/ws", s.wsHandler)
+
+	httpServer := &http.Server{Addr: httpServerAddress, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		httpServer.Close()
+	}()
+
+	err := httpServer.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// publishSyncProgress syncs the block chain, publishing each progress update to
+// s.eventBus so /ws subscribers (and, if running, the grpc service) see it.
+func (s *server) publishSyncProgress() {
+	listener := &app.BlockChainSyncListener{
+		SyncStarted: func() {},
+		SyncEnded:   func(err error) {},
+		OnHeadersFetched: func(percentageProgress int64) {
+			s.eventBus.Publish(app.Event{
+				Type: app.EventSyncProgress,
+				Data: app.SyncProgressEvent{Stage: "headers_fetched", PercentageProgress: percentageProgress},
+			})
+		},
+		OnDiscoveredAddress: func(state string) {
+			s.eventBus.Publish(app.Event{
+				Type: app.EventSyncProgress,
+				Data: app.SyncProgressEvent{Stage: "discovered_address", State: state},
+			})
+		},
+		OnRescanningBlocks: func(percentageProgress int64) {
+			s.eventBus.Publish(app.Event{
+				Type: app.EventSyncProgress,
+				Data: app.SyncProgressEvent{Stage: "rescanning_blocks", PercentageProgress: percentageProgress},
+			})
+		},
+	}
+
+	s.walletMiddleware.SyncBlockChain(listener, false)
+}
+
+// publishNewTransactions subscribes to new-transaction notifications from the
+// connected wallet, if it supports them, publishing each one to s.eventBus so /ws
+// subscribers can append new rows to the history view without polling.
+func (s *server) publishNewTransactions() {
+	notifier, ok := s.walletMiddleware.(app.TransactionNotifier)
+	if !ok {
+		return
+	}
+
+	notifier.SubscribeToTransactions(&app.TransactionNotificationListener{
+		OnTransaction: func(transaction app.NewTransactionEvent) {
+			s.eventBus.Publish(app.Event{Type: app.EventNewTransaction, Data: transaction})
+		},
+	})
+}
+
+// stakeHandler renders the live ticket status page. It returns a clear error
+// if the connected wallet doesn't support staking rather than a generic 404.
+func (s *server) stakeHandler(w http.ResponseWriter, r *http.Request) {
+	stakingMiddleware, ok := s.walletMiddleware.(app.StakingMiddleware)
+	if !ok {
+		http.Error(w, "connected wallet does not support staking", http.StatusNotImplemented)
+		return
+	}
+
+	tickets, err := stakingMiddleware.Tickets()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	renderTicketsPage(w, tickets)
+}
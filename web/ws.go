@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: checkOrigin,
+}
+
+// checkOrigin rejects cross-origin upgrade requests, so a page from another origin
+// open in the user's browser can't connect to /ws and read live wallet events.
+// Requests with no Origin header (e.g. non-browser clients) are allowed through.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return originURL.Host == r.Host
+}
+
+// wsHandler upgrades the request to a websocket and streams sync progress and new
+// transaction events to the browser as they're published on the server's event bus,
+// so the dashboard can update live instead of polling.
+func (s *server) wsHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	events := s.eventBus.Subscribe()
+	defer s.eventBus.Unsubscribe(events)
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		}
+	}
+}